@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+func cmdDash(args []string) {
+	fs := flag.NewFlagSet("dash", flag.ExitOnError)
+	noColor := fs.Bool("no-color", false, "Disable ANSI color codes")
+	colsStr := fs.String("cols", "", "Comma-separated columns for each section's table: id,name,start,due,status,tags,notes,age")
+	fs.Parse(args)
+
+	cols, err := parseColumns(*colsStr)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	now := time.Now()
+	color := colorEnabled(*noColor)
+
+	var overdue, dueThisWeek, upcoming, recentlyDone []Project
+	tagCounts := map[string]int{}
+	active, done := 0, 0
+
+	for _, p := range state.Projects {
+		for _, t := range p.Tags {
+			tagCounts[strings.ToLower(t)]++
+		}
+
+		if p.Done {
+			done++
+			if !p.LastCompleted.IsZero() && now.Sub(p.LastCompleted) <= 7*24*time.Hour {
+				recentlyDone = append(recentlyDone, p)
+			}
+			continue
+		}
+
+		active++
+		switch {
+		case isOverdue(p, now):
+			overdue = append(overdue, p)
+		case daysLeft(p, now) <= 7:
+			dueThisWeek = append(dueThisWeek, p)
+		default:
+			upcoming = append(upcoming, p)
+		}
+	}
+
+	fmt.Printf("%d overdue · %d active · %d done\n\n", len(overdue), active, done)
+
+	printDashSection(os.Stdout, "Overdue", overdue, cols, now, color)
+	printDashSection(os.Stdout, "Due this week", dueThisWeek, cols, now, color)
+	printDashSection(os.Stdout, "Upcoming", upcoming, cols, now, color)
+	printDashSection(os.Stdout, "Recently completed (last 7 days)", recentlyDone, cols, now, color)
+
+	if len(tagCounts) == 0 {
+		return
+	}
+
+	tags := make([]string, 0, len(tagCounts))
+	for t := range tagCounts {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+
+	fmt.Println("Tags")
+	for _, t := range tags {
+		fmt.Printf("  %-20s %d\n", t, tagCounts[t])
+	}
+}
+
+func printDashSection(w io.Writer, title string, projects []Project, cols []Column, now time.Time, color bool) {
+	fmt.Fprintf(w, "%s (%d)\n", title, len(projects))
+	if len(projects) == 0 {
+		fmt.Fprintln(w, "  (none)")
+		fmt.Fprintln(w)
+		return
+	}
+
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].DueDate.Before(projects[j].DueDate)
+	})
+	printTable(w, projects, cols, now, color)
+	fmt.Fprintln(w)
+}
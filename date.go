@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+const acceptedDateForms = `expected YYYY-MM-DD, RFC3339, "today", "tomorrow", a weekday name, "+Nd"/"+Nw"/"+Nm", "eow", or "eom"`
+
+// parseDate parses a due/start date against the current time. Accepted
+// forms: "today", "tomorrow", a weekday name (next occurrence of that
+// weekday), "+Nd"/"+Nw"/"+Nm" offsets, "eow" (end of week), "eom" (end of
+// month), the plain YYYY-MM-DD form, and RFC3339 with timezone.
+func parseDate(s string) (time.Time, error) {
+	return parseDateAt(s, time.Now())
+}
+
+func parseDateAt(s string, now time.Time) (time.Time, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(s))
+
+	switch trimmed {
+	case "today":
+		return truncateToDate(now), nil
+	case "tomorrow":
+		return truncateToDate(now).AddDate(0, 0, 1), nil
+	case "eow":
+		return endOfWeek(now), nil
+	case "eom":
+		return endOfMonth(now), nil
+	}
+
+	if wd, ok := weekdayNames[trimmed]; ok {
+		return nextWeekday(now, wd), nil
+	}
+
+	if t, ok := parseDateOffset(trimmed, now); ok {
+		return t, nil
+	}
+
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q (%s)", s, acceptedDateForms)
+}
+
+// parseDateOffset handles "+Nd", "+Nw", "+Nm" (days/weeks/months from today).
+func parseDateOffset(s string, now time.Time) (time.Time, bool) {
+	if !strings.HasPrefix(s, "+") || len(s) < 3 {
+		return time.Time{}, false
+	}
+
+	unit := s[len(s)-1]
+	n, err := strconv.Atoi(s[1 : len(s)-1])
+	if err != nil || n <= 0 {
+		return time.Time{}, false
+	}
+
+	base := truncateToDate(now)
+	switch unit {
+	case 'd':
+		return base.AddDate(0, 0, n), true
+	case 'w':
+		return base.AddDate(0, 0, 7*n), true
+	case 'm':
+		return base.AddDate(0, n, 0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// nextWeekday walks forward from now (exclusive) up to 7 days to find the
+// next date falling on weekday wd.
+func nextWeekday(now time.Time, wd time.Weekday) time.Time {
+	base := truncateToDate(now)
+	for i := 1; i <= 7; i++ {
+		candidate := base.AddDate(0, 0, i)
+		if candidate.Weekday() == wd {
+			return candidate
+		}
+	}
+	return base // unreachable: every weekday occurs within 7 days
+}
+
+func endOfWeek(now time.Time) time.Time {
+	base := truncateToDate(now)
+	if base.Weekday() == time.Sunday {
+		return base
+	}
+	return nextWeekday(now, time.Sunday)
+}
+
+func endOfMonth(now time.Time) time.Time {
+	firstOfNextMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, 1, 0)
+	return firstOfNextMonth.AddDate(0, 0, -1)
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -30,6 +29,43 @@ type Project struct {
 	Done      bool      `json:"done"`
 	Tags      []string  `json:"tags,omitempty"`
 	Notes     string    `json:"notes,omitempty"`
+
+	// Recur holds an RFC 5545-style recurrence rule (or shorthand like
+	// "daily"/"every 2 weeks"), empty if the project does not recur.
+	Recur         string    `json:"recur,omitempty"`
+	LastCompleted time.Time `json:"last_completed,omitempty"`
+
+	// Streaks is the work-log history for this project: one entry per
+	// start/stop cycle. An entry with a zero End is the currently open one.
+	Streaks []Streak `json:"streaks,omitempty"`
+
+	// UpdatedAt and Deleted support last-write-wins sync across Store
+	// backends: Deleted is a tombstone rather than an outright removal so
+	// the deletion itself can propagate to other hosts.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	Deleted   bool      `json:"deleted,omitempty"`
+}
+
+// Streak is a single logged span of work on a project.
+type Streak struct {
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+func (s Streak) open() bool {
+	return s.End.IsZero()
+}
+
+func (s Streak) duration(now time.Time) time.Duration {
+	if s.open() {
+		return now.Sub(s.Start)
+	}
+	return s.End.Sub(s.Start)
+}
+
+func (p Project) isRecurring() bool {
+	return strings.TrimSpace(p.Recur) != ""
 }
 
 // Storage file location: ~/.projtrack.json
@@ -41,41 +77,68 @@ func storagePath() (string, error) {
 	return filepath.Join(home, ".projtrack.json"), nil
 }
 
-func loadProjects() ([]Project, error) {
-	path, err := storagePath()
+// trackerState is the in-memory view commands operate on: the project list
+// from the configured Store, plus local-only tracker state like which
+// project currently has an open work streak (see trackerMeta).
+type trackerState struct {
+	Projects         []Project
+	CurrentProjectID int
+}
+
+func loadState() (*trackerState, error) {
+	store, err := resolveStore()
 	if err != nil {
 		return nil, err
 	}
-
-	// If file doesn't exist yet, return empty list
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return []Project{}, nil
-	}
-
-	data, err := os.ReadFile(path)
+	projects, err := store.List()
 	if err != nil {
 		return nil, err
 	}
 
-	var projects []Project
-	if err := json.Unmarshal(data, &projects); err != nil {
+	meta, err := loadMeta()
+	if err != nil {
 		return nil, err
 	}
-	return projects, nil
+
+	return &trackerState{Projects: projects, CurrentProjectID: meta.CurrentProjectID}, nil
 }
 
-func saveProjects(projects []Project) error {
-	path, err := storagePath()
+// savePartial persists only the named projects (by ID) from state, plus
+// local meta, rather than re-Putting every project in memory. Putting the
+// whole list back on every save is fine against the local file backend
+// (it's one process, one file) but would stomp a concurrent edit from
+// another host the moment a shared Store (sqlite/http) is configured
+// directly - each command loads a full snapshot, and an unrelated edit
+// made elsewhere between that load and this save would get overwritten
+// by the stale in-memory copy of whatever it didn't touch. Pass no ids to
+// persist only meta (e.g. clearing CurrentProjectID without touching any
+// project). Commands that remove a project should call the Store's
+// Delete directly instead.
+func savePartial(state *trackerState, ids ...int) error {
+	store, err := resolveStore()
 	if err != nil {
 		return err
 	}
-
-	data, err := json.MarshalIndent(projects, "", "  ")
-	if err != nil {
-		return err
+	for _, id := range ids {
+		p := state.find(id)
+		if p == nil {
+			continue
+		}
+		if err := store.Put(*p); err != nil {
+			return err
+		}
 	}
 
-	return os.WriteFile(path, data, 0o644)
+	return saveMeta(&trackerMeta{CurrentProjectID: state.CurrentProjectID})
+}
+
+func (s *trackerState) find(id int) *Project {
+	for i := range s.Projects {
+		if s.Projects[i].ID == id {
+			return &s.Projects[i]
+		}
+	}
+	return nil
 }
 
 func nextID(projects []Project) int {
@@ -88,12 +151,6 @@ func nextID(projects []Project) int {
 	return maxID + 1
 }
 
-func parseDate(s string) (time.Time, error) {
-	// Expect YYYY-MM-DD
-	const layout = "2006-01-02"
-	return time.Parse(layout, s)
-}
-
 func formatDate(t time.Time) string {
 	return t.Format("2006-01-02")
 }
@@ -136,10 +193,11 @@ func truncateToDate(t time.Time) time.Time {
 func cmdAdd(args []string) {
 	fs := flag.NewFlagSet("add", flag.ExitOnError)
 	name := fs.String("name", "", "Project name (required)")
-	startStr := fs.String("start", "", "Start date YYYY-MM-DD (optional, defaults to today)")
-	dueStr := fs.String("due", "", "Due date YYYY-MM-DD (required)")
+	startStr := fs.String("start", "", `Start date: YYYY-MM-DD, RFC3339, "today", "tomorrow", a weekday, "+Nd/w/m", "eow", or "eom" (optional, defaults to today)`)
+	dueStr := fs.String("due", "", `Due date: YYYY-MM-DD, RFC3339, "today", "tomorrow", a weekday, "+Nd/w/m", "eow", or "eom" (required)`)
 	tagsStr := fs.String("tags", "", "Comma-separated tags (optional)")
 	notes := fs.String("notes", "", "Notes/description (optional)")
+	recurStr := fs.String("recur", "", `Recurrence rule, e.g. "daily", "every 2 weeks", or "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO" (optional)`)
 	fs.Parse(args)
 
 	if *name == "" {
@@ -152,6 +210,15 @@ func cmdAdd(args []string) {
 		fs.Usage()
 		os.Exit(1)
 	}
+	var recur string
+	if *recurStr != "" {
+		rule, err := parseRecur(*recurStr)
+		if err != nil {
+			fmt.Printf("Invalid -recur: %v\n", err)
+			os.Exit(1)
+		}
+		recur = rule.String()
+	}
 
 	now := time.Now()
 
@@ -173,7 +240,7 @@ func cmdAdd(args []string) {
 		os.Exit(1)
 	}
 
-	projects, err := loadProjects()
+	state, err := loadState()
 	if err != nil {
 		fmt.Printf("Error loading projects: %v\n", err)
 		os.Exit(1)
@@ -190,18 +257,20 @@ func cmdAdd(args []string) {
 	}
 
 	p := Project{
-		ID:        nextID(projects),
+		ID:        nextID(state.Projects),
 		Name:      *name,
 		StartDate: startDate,
 		DueDate:   dueDate,
 		Done:      false,
 		Tags:      tags,
 		Notes:     *notes,
+		Recur:     recur,
+		UpdatedAt: now,
 	}
 
-	projects = append(projects, p)
+	state.Projects = append(state.Projects, p)
 
-	if err := saveProjects(projects); err != nil {
+	if err := savePartial(state, p.ID); err != nil {
 		fmt.Printf("Error saving projects: %v\n", err)
 		os.Exit(1)
 	}
@@ -214,6 +283,107 @@ func cmdAdd(args []string) {
 	if strings.TrimSpace(p.Notes) != "" {
 		fmt.Println("  Notes:", p.Notes)
 	}
+	if p.isRecurring() {
+		fmt.Printf("  Recurs: %s\n", p.Recur)
+	}
+}
+
+func cmdEdit(args []string) {
+	fs := flag.NewFlagSet("edit", flag.ExitOnError)
+	idStr := fs.String("id", "", "Project ID to edit (required)")
+	name := fs.String("name", "", "New project name")
+	startStr := fs.String("start", "", "New start date")
+	dueStr := fs.String("due", "", "New due date")
+	tagsStr := fs.String("tags", "", "New comma-separated tags (replaces existing tags)")
+	notes := fs.String("notes", "", "New notes")
+	recurStr := fs.String("recur", "", "New recurrence rule (empty clears it)")
+	fs.Parse(args)
+
+	if *idStr == "" {
+		fmt.Println("Error: -id is required")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	id, err := strconv.Atoi(*idStr)
+	if err != nil {
+		fmt.Printf("Invalid ID: %v\n", err)
+		os.Exit(1)
+	}
+
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if len(set) == 0 {
+		fmt.Println("Nothing to update; pass at least one of -name, -start, -due, -tags, -notes, -recur")
+		os.Exit(1)
+	}
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	p := state.find(id)
+	if p == nil {
+		fmt.Printf("No project with ID %d\n", id)
+		os.Exit(1)
+	}
+
+	if set["name"] {
+		p.Name = *name
+	}
+	if set["start"] {
+		start, err := parseDate(*startStr)
+		if err != nil {
+			fmt.Printf("Invalid start date: %v\n", err)
+			os.Exit(1)
+		}
+		p.StartDate = start
+	}
+	if set["due"] {
+		due, err := parseDate(*dueStr)
+		if err != nil {
+			fmt.Printf("Invalid due date: %v\n", err)
+			os.Exit(1)
+		}
+		p.DueDate = due
+	}
+	if set["tags"] {
+		var tags []string
+		for _, t := range strings.Split(*tagsStr, ",") {
+			tag := strings.TrimSpace(t)
+			if tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		p.Tags = tags
+	}
+	if set["notes"] {
+		p.Notes = *notes
+	}
+	if set["recur"] {
+		recur := *recurStr
+		if recur != "" {
+			rule, err := parseRecur(recur)
+			if err != nil {
+				fmt.Printf("Invalid -recur: %v\n", err)
+				os.Exit(1)
+			}
+			recur = rule.String()
+		}
+		p.Recur = recur
+	}
+
+	p.UpdatedAt = time.Now()
+
+	if err := savePartial(state, id); err != nil {
+		fmt.Printf("Error saving projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Updated project #%d: %s (start: %s, due: %s)\n",
+		p.ID, p.Name, formatDate(p.StartDate), formatDate(p.DueDate))
 }
 
 func hasTag(p Project, tag string) bool {
@@ -248,15 +418,36 @@ func cmdList(args []string) {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 	status := fs.String("status", "all", "Status filter: all|active|done|overdue")
 	tag := fs.String("tag", "", "Filter by tag (case-insensitive)")
+	formatStr := fs.String("format", "table", "Output format: table|json|tsv|template")
+	templateStr := fs.String("template", "", `Go text/template for -format template, e.g. '{{.ID}} {{.Name}}'`)
+	noColor := fs.Bool("no-color", false, "Disable ANSI color codes")
+	colsStr := fs.String("cols", "", "Comma-separated columns for -format table: id,name,start,due,status,tags,notes,age")
 	fs.Parse(args)
 
-	projects, err := loadProjects()
+	format, err := parseOutputFormat(*formatStr)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if format == formatTemplate && *templateStr == "" {
+		fmt.Println("Error: -template is required when -format=template")
+		os.Exit(1)
+	}
+
+	cols, err := parseColumns(*colsStr)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	state, err := loadState()
 	if err != nil {
 		fmt.Printf("Error loading projects: %v\n", err)
 		os.Exit(1)
 	}
+	projects := state.Projects
 
-	if len(projects) == 0 {
+	if len(projects) == 0 && format == formatTable {
 		fmt.Println("No projects found yet. Add one with `projtrack add`.")
 		return
 	}
@@ -268,8 +459,7 @@ func cmdList(args []string) {
 		return projects[i].DueDate.Before(projects[j].DueDate)
 	})
 
-	fmt.Println("ID  NAME                           START       DUE         STATUS               TAGS")
-	fmt.Println("----------------------------------------------------------------------------------------")
+	var filtered []Project
 	for _, p := range projects {
 		if !matchesStatusFilter(p, *status, now) {
 			continue
@@ -277,20 +467,29 @@ func cmdList(args []string) {
 		if !hasTag(p, *tag) {
 			continue
 		}
+		filtered = append(filtered, p)
+	}
 
-		color, statusLabel := statusColorAndLabel(p, now)
-		tagsJoined := strings.Join(p.Tags, ",")
-		fmt.Printf("%-3d %-30s %-10s %-10s %s%-20s%s %-20s\n",
-			p.ID,
-			truncate(p.Name, 30),
-			formatDate(p.StartDate),
-			formatDate(p.DueDate),
-			color,
-			statusLabel,
-			ColorReset,
-			truncate(tagsJoined, 20),
-		)
+	switch format {
+	case formatJSON:
+		if err := renderJSON(os.Stdout, filtered, now); err != nil {
+			fmt.Printf("Error rendering json: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	case formatTSV:
+		renderTSV(os.Stdout, filtered, now)
+		return
+	case formatTemplate:
+		if err := renderTemplate(os.Stdout, *templateStr, filtered); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
 	}
+
+	color := colorEnabled(*noColor)
+	printTable(os.Stdout, filtered, cols, now, color)
 }
 
 func truncate(s string, max int) string {
@@ -320,27 +519,58 @@ func cmdDone(args []string) {
 		os.Exit(1)
 	}
 
-	projects, err := loadProjects()
+	state, err := loadState()
 	if err != nil {
 		fmt.Printf("Error loading projects: %v\n", err)
 		os.Exit(1)
 	}
 
-	found := false
-	for i := range projects {
-		if projects[i].ID == id {
-			projects[i].Done = true
-			found = true
-			break
-		}
-	}
+	now := time.Now()
 
-	if !found {
+	p := state.find(id)
+	if p == nil {
 		fmt.Printf("No project with ID %d\n", id)
 		os.Exit(1)
 	}
 
-	if err := saveProjects(projects); err != nil {
+	p.Done = true
+	p.LastCompleted = now
+	p.UpdatedAt = now
+
+	if p.isRecurring() {
+		rule, err := parseRecur(p.Recur)
+		if err != nil {
+			fmt.Printf("Error parsing recurrence rule for project #%d: %v\n", id, err)
+			os.Exit(1)
+		}
+
+		span := p.DueDate.Sub(p.StartDate)
+		nextDue := nextOccurrence(rule, p.DueDate, now)
+
+		sibling := Project{
+			ID:        nextID(state.Projects),
+			Name:      p.Name,
+			StartDate: nextDue.Add(-span),
+			DueDate:   nextDue,
+			Done:      false,
+			Tags:      p.Tags,
+			Notes:     p.Notes,
+			Recur:     p.Recur,
+			UpdatedAt: now,
+		}
+		state.Projects = append(state.Projects, sibling)
+
+		if err := savePartial(state, id, sibling.ID); err != nil {
+			fmt.Printf("Error saving projects: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Marked project #%d as done. Next occurrence: #%d, due %s\n",
+			id, sibling.ID, formatDate(sibling.DueDate))
+		return
+	}
+
+	if err := savePartial(state, id); err != nil {
 		fmt.Printf("Error saving projects: %v\n", err)
 		os.Exit(1)
 	}
@@ -351,6 +581,9 @@ func cmdDone(args []string) {
 func cmdShow(args []string) {
 	fs := flag.NewFlagSet("show", flag.ExitOnError)
 	idStr := fs.String("id", "", "Project ID to show (required)")
+	formatStr := fs.String("format", "table", "Output format: table|json|tsv|template")
+	templateStr := fs.String("template", "", `Go text/template for -format template, e.g. '{{.ID}} {{.Name}}'`)
+	noColor := fs.Bool("no-color", false, "Disable ANSI color codes")
 	fs.Parse(args)
 
 	if *idStr == "" {
@@ -359,28 +592,60 @@ func cmdShow(args []string) {
 		os.Exit(1)
 	}
 
+	format, err := parseOutputFormat(*formatStr)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if format == formatTemplate && *templateStr == "" {
+		fmt.Println("Error: -template is required when -format=template")
+		os.Exit(1)
+	}
+
 	id, err := strconv.Atoi(*idStr)
 	if err != nil {
 		fmt.Printf("Invalid ID: %v\n", err)
 		os.Exit(1)
 	}
 
-	projects, err := loadProjects()
+	state, err := loadState()
 	if err != nil {
 		fmt.Printf("Error loading projects: %v\n", err)
 		os.Exit(1)
 	}
 
 	now := time.Now()
-	for _, p := range projects {
+	for _, p := range state.Projects {
 		if p.ID == id {
+			switch format {
+			case formatJSON:
+				if err := renderJSON(os.Stdout, []Project{p}, now); err != nil {
+					fmt.Printf("Error rendering json: %v\n", err)
+					os.Exit(1)
+				}
+				return
+			case formatTSV:
+				renderTSV(os.Stdout, []Project{p}, now)
+				return
+			case formatTemplate:
+				if err := renderTemplate(os.Stdout, *templateStr, []Project{p}); err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				return
+			}
+
 			color, statusLabel := statusColorAndLabel(p, now)
+			reset := ColorReset
+			if !colorEnabled(*noColor) {
+				color, reset = "", ""
+			}
 			fmt.Printf("Project #%d\n", p.ID)
 			fmt.Println("--------------------------------------------------")
 			fmt.Println("Name:   ", p.Name)
 			fmt.Println("Start:  ", formatDate(p.StartDate))
 			fmt.Println("Due:    ", formatDate(p.DueDate))
-			fmt.Printf("Status: %s%s%s\n", color, statusLabel, ColorReset)
+			fmt.Printf("Status: %s%s%s\n", color, statusLabel, reset)
 			if len(p.Tags) > 0 {
 				fmt.Println("Tags:   ", strings.Join(p.Tags, ", "))
 			} else {
@@ -392,6 +657,16 @@ func cmdShow(args []string) {
 			} else {
 				fmt.Println("Notes:   (none)")
 			}
+
+			total := totalLogged(p, now)
+			fmt.Printf("Logged: %s\n", formatDuration(total))
+			if open, ok := openStreak(p); ok {
+				fmt.Printf("Active: since %s (%s elapsed)", open.Start.Format("2006-01-02 15:04"), formatDuration(now.Sub(open.Start)))
+				if strings.TrimSpace(open.Description) != "" {
+					fmt.Printf(" — %s", open.Description)
+				}
+				fmt.Println()
+			}
 			return
 		}
 	}
@@ -399,6 +674,117 @@ func cmdShow(args []string) {
 	fmt.Printf("No project with ID %d\n", id)
 }
 
+func cmdNext(args []string) {
+	fs := flag.NewFlagSet("next", flag.ExitOnError)
+	idStr := fs.String("id", "", "Recurring project ID to preview (required)")
+	days := fs.Int("days", 30, "Preview occurrences over the next N days")
+	fs.Parse(args)
+
+	if *idStr == "" {
+		fmt.Println("Error: -id is required")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	id, err := strconv.Atoi(*idStr)
+	if err != nil {
+		fmt.Printf("Invalid ID: %v\n", err)
+		os.Exit(1)
+	}
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, p := range state.Projects {
+		if p.ID != id {
+			continue
+		}
+		if !p.isRecurring() {
+			fmt.Printf("Project #%d does not have a recurrence rule set.\n", id)
+			os.Exit(1)
+		}
+
+		rule, err := parseRecur(p.Recur)
+		if err != nil {
+			fmt.Printf("Error parsing recurrence rule: %v\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now()
+		occurrences := upcomingOccurrences(rule, p.DueDate, now, *days)
+		if len(occurrences) == 0 {
+			fmt.Printf("No occurrences of project #%d in the next %d days.\n", id, *days)
+			return
+		}
+
+		fmt.Printf("Upcoming occurrences of #%d (%s), rule %q:\n", p.ID, p.Name, p.Recur)
+		for _, occ := range occurrences {
+			fmt.Println(" -", formatDate(occ))
+		}
+		return
+	}
+
+	fmt.Printf("No project with ID %d\n", id)
+	os.Exit(1)
+}
+
+// cmdDelete tombstones a project via the configured Store's Delete, rather
+// than going through loadState/saveState (which only ever upserts — see
+// saveState's doc comment). This is the only way a Deleted flag is ever set
+// from the CLI, which is what lets `sync` propagate a removal to other
+// hosts instead of just hiding the project locally.
+func cmdDelete(args []string) {
+	fs := flag.NewFlagSet("delete", flag.ExitOnError)
+	idStr := fs.String("id", "", "Project ID to delete (required)")
+	fs.Parse(args)
+
+	if *idStr == "" {
+		fmt.Println("Error: -id is required")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	id, err := strconv.Atoi(*idStr)
+	if err != nil {
+		fmt.Printf("Invalid ID: %v\n", err)
+		os.Exit(1)
+	}
+
+	store, err := resolveStore()
+	if err != nil {
+		fmt.Printf("Error resolving store: %v\n", err)
+		os.Exit(1)
+	}
+
+	p, err := store.Get(id)
+	if err != nil {
+		fmt.Printf("Error loading project #%d: %v\n", id, err)
+		os.Exit(1)
+	}
+	if p == nil {
+		fmt.Printf("No project with ID %d\n", id)
+		os.Exit(1)
+	}
+
+	if err := store.Delete(id); err != nil {
+		fmt.Printf("Error deleting project #%d: %v\n", id, err)
+		os.Exit(1)
+	}
+
+	if meta, err := loadMeta(); err == nil && meta.CurrentProjectID == id {
+		meta.CurrentProjectID = 0
+		if err := saveMeta(meta); err != nil {
+			fmt.Printf("Error clearing current project: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("Deleted project #%d (%s).\n", id, p.Name)
+}
+
 func printUsage() {
 	fmt.Println(`Usage:
   projtrack <command> [options]
@@ -407,18 +793,50 @@ Commands:
   add    Add a new project
   list   List projects
   done   Mark a project as done
+  edit   Change fields on an existing project
+  delete Remove a project (tombstoned so the removal syncs to other hosts)
   show   Show full details for a project
+  next   Preview upcoming occurrences of a recurring project
+  start  Start a work streak on a project
+  stop   Stop the currently running work streak
+  report Summarize logged time
+  sync   Merge local projects with the configured shared backend
+  dash   Grouped overview: overdue, due this week, upcoming, recently done
+
+Storage:
+  By default projects live in ~/.projtrack.json. Set PROJTRACK_STORE to
+  "sqlite" or an "http(s)://..." URL (or the store="..." key in
+  ~/.projtrack.toml) to use a shared backend instead.
 
 Examples:
   projtrack add -name "FPGA Toolchain" -start 2025-11-21 -due 2025-12-10 \
     -tags "work,fpga" -notes "Prototype flow with new board."
 
+  projtrack add -name "Standup notes" -due 2025-11-24 -recur "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+
   projtrack list
   projtrack list -status overdue
   projtrack list -status active -tag work
 
   projtrack done -id 1
-  projtrack show -id 1`)
+  projtrack edit -id 1 -due tomorrow
+  projtrack edit -id 1 -due +2w -tags "work,urgent"
+  projtrack delete -id 1
+  projtrack show -id 1
+  projtrack next -id 1 -days 60
+
+  projtrack start -id 1 -desc "Implementing recurrence parser"
+  projtrack stop
+  projtrack report -by week -round 15m
+
+  PROJTRACK_STORE=https://projtrack.example.com projtrack sync
+
+  projtrack list -format json | jq '.[] | select(.overdue)'
+  projtrack list -format tsv > projects.tsv
+  projtrack show -id 1 -format template -template '{{.Name}} due {{.DueDate}}'
+
+  projtrack dash
+  projtrack list -cols id,name,due,age`)
 }
 
 func main() {
@@ -437,8 +855,24 @@ func main() {
 		cmdList(args)
 	case "done":
 		cmdDone(args)
+	case "edit":
+		cmdEdit(args)
+	case "delete":
+		cmdDelete(args)
 	case "show":
 		cmdShow(args)
+	case "next":
+		cmdNext(args)
+	case "start":
+		cmdStart(args)
+	case "stop":
+		cmdStop(args)
+	case "report":
+		cmdReport(args)
+	case "sync":
+		cmdSync(args)
+	case "dash":
+		cmdDash(args)
 	case "help", "-h", "--help":
 		printUsage()
 	default:
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// trackerMeta holds small local-only state that should never migrate
+// between hosts when syncing projects — e.g. which project has an open
+// work streak on *this* machine. It always lives in a local file regardless
+// of which Store backend is configured for projects.
+type trackerMeta struct {
+	CurrentProjectID int `json:"current_project_id,omitempty"`
+}
+
+func metaPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".projtrack.meta.json"), nil
+}
+
+func loadMeta() (*trackerMeta, error) {
+	path, err := metaPath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &trackerMeta{}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var meta trackerMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+func saveMeta(meta *trackerMeta) error {
+	path, err := metaPath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurRule is a parsed recurrence definition. It covers the handful of
+// RFC 5545 RRULE parts this tool actually needs (FREQ, INTERVAL, BYDAY,
+// BYMONTHDAY), plus the shorthand forms ("daily", "every 2 weeks") that get
+// normalized into the same fields.
+type recurRule struct {
+	Freq       string // DAILY, WEEKLY, MONTHLY
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay int // 0 means unset
+}
+
+var weekdayAbbrs = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// parseRecur parses a recurrence rule given as either a shorthand keyword
+// ("daily", "weekly", "monthly", "every 2 weeks") or an RFC 5545-style
+// string ("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO").
+func parseRecur(s string) (*recurRule, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty recurrence rule")
+	}
+
+	if rule, ok := parseShorthandRecur(s); ok {
+		return rule, nil
+	}
+
+	if strings.Contains(s, "=") {
+		return parseRRule(s)
+	}
+
+	return nil, fmt.Errorf("unrecognized recurrence rule %q (expected daily, weekly, monthly, \"every N weeks\", or FREQ=...)", s)
+}
+
+func parseShorthandRecur(s string) (*recurRule, bool) {
+	lower := strings.ToLower(strings.TrimSpace(s))
+
+	switch lower {
+	case "daily":
+		return &recurRule{Freq: "DAILY", Interval: 1}, true
+	case "weekly":
+		return &recurRule{Freq: "WEEKLY", Interval: 1}, true
+	case "monthly":
+		return &recurRule{Freq: "MONTHLY", Interval: 1}, true
+	}
+
+	// "every 2 weeks", "every day", "every month"
+	fields := strings.Fields(lower)
+	if len(fields) == 2 && fields[0] == "every" {
+		return shorthandFreq(1, fields[1])
+	}
+	if len(fields) == 3 && fields[0] == "every" {
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n <= 0 {
+			return nil, false
+		}
+		return shorthandFreq(n, fields[2])
+	}
+
+	return nil, false
+}
+
+func shorthandFreq(interval int, unit string) (*recurRule, bool) {
+	unit = strings.TrimSuffix(unit, "s")
+	switch unit {
+	case "day":
+		return &recurRule{Freq: "DAILY", Interval: interval}, true
+	case "week":
+		return &recurRule{Freq: "WEEKLY", Interval: interval}, true
+	case "month":
+		return &recurRule{Freq: "MONTHLY", Interval: interval}, true
+	}
+	return nil, false
+}
+
+// parseRRule tokenizes a semicolon-separated FREQ=...;INTERVAL=...;BYDAY=...
+// string. Unknown parts are rejected rather than silently ignored, since a
+// typo'd part here would otherwise produce a silently wrong schedule.
+func parseRRule(s string) (*recurRule, error) {
+	rule := &recurRule{Interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("malformed recurrence part %q", part)
+		}
+		key, val := strings.ToUpper(strings.TrimSpace(kv[0])), strings.TrimSpace(kv[1])
+
+		switch key {
+		case "FREQ":
+			freq := strings.ToUpper(val)
+			if freq != "DAILY" && freq != "WEEKLY" && freq != "MONTHLY" {
+				return nil, fmt.Errorf("unsupported FREQ %q (expected DAILY, WEEKLY, or MONTHLY)", val)
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", val)
+			}
+			rule.Interval = n
+		case "BYDAY":
+			for _, abbr := range strings.Split(val, ",") {
+				abbr = strings.ToUpper(strings.TrimSpace(abbr))
+				wd, ok := weekdayAbbrs[abbr]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY value %q", abbr)
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 31 {
+				return nil, fmt.Errorf("invalid BYMONTHDAY %q", val)
+			}
+			rule.ByMonthDay = n
+		default:
+			return nil, fmt.Errorf("unsupported recurrence part %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("recurrence rule is missing FREQ")
+	}
+	return rule, nil
+}
+
+// String renders the rule back in RFC 5545 form, used when persisting a
+// shorthand rule so later runs parse a canonical representation.
+func (r *recurRule) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "FREQ=%s;INTERVAL=%d", r.Freq, r.Interval)
+	if len(r.ByDay) > 0 {
+		abbrs := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			abbrs[i] = weekdayAbbr(wd)
+		}
+		fmt.Fprintf(&b, ";BYDAY=%s", strings.Join(abbrs, ","))
+	}
+	if r.ByMonthDay != 0 {
+		fmt.Fprintf(&b, ";BYMONTHDAY=%d", r.ByMonthDay)
+	}
+	return b.String()
+}
+
+// lastDayOfMonth returns the number of days in t's month.
+func lastDayOfMonth(t time.Time) int {
+	firstOfNext := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+	return firstOfNext.AddDate(0, 0, -1).Day()
+}
+
+func weekdayAbbr(wd time.Weekday) string {
+	for abbr, w := range weekdayAbbrs {
+		if w == wd {
+			return abbr
+		}
+	}
+	return ""
+}
+
+// nextOccurrence advances anchor by one cycle of the rule, then keeps
+// advancing (to cover missed cycles) until the result is strictly after now.
+func nextOccurrence(rule *recurRule, anchor time.Time, now time.Time) time.Time {
+	next := advanceOnce(rule, anchor)
+	for !next.After(now) {
+		next = advanceOnce(rule, next)
+	}
+	return next
+}
+
+func advanceOnce(rule *recurRule, from time.Time) time.Time {
+	switch rule.Freq {
+	case "DAILY":
+		return from.AddDate(0, 0, rule.Interval)
+	case "WEEKLY":
+		if len(rule.ByDay) > 0 {
+			return nextByDay(rule, from)
+		}
+		return from.AddDate(0, 0, 7*rule.Interval)
+	case "MONTHLY":
+		// Advance the 1st of the month first, not `from` itself: adding
+		// months to a day-of-month that doesn't exist in the target month
+		// (e.g. the 31st) makes time.Time normalize the overflow into the
+		// following month, silently skipping the short month entirely. The
+		// 1st never overflows, so advance on it and clamp the target day -
+		// BYMONTHDAY if the rule set one, otherwise from's own day-of-month
+		// (the bare "FREQ=MONTHLY"/shorthand case) - to whatever the target
+		// month actually has.
+		targetDay := rule.ByMonthDay
+		if targetDay == 0 {
+			targetDay = from.Day()
+		}
+		firstOfTarget := time.Date(from.Year(), from.Month(), 1, from.Hour(), from.Minute(), from.Second(), 0, from.Location()).
+			AddDate(0, rule.Interval, 0)
+		if last := lastDayOfMonth(firstOfTarget); targetDay > last {
+			targetDay = last
+		}
+		return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), targetDay, from.Hour(), from.Minute(), from.Second(), 0, from.Location())
+	default:
+		return from.AddDate(0, 0, rule.Interval)
+	}
+}
+
+// nextByDay walks forward day-by-day from the anchor (skipping the anchor
+// itself) until it lands on a weekday present in rule.ByDay, then jumps the
+// remaining INTERVAL-1 weeks.
+func nextByDay(rule *recurRule, from time.Time) time.Time {
+	for i := 1; i <= 7; i++ {
+		candidate := from.AddDate(0, 0, i)
+		if isByDay(rule, candidate.Weekday()) {
+			return candidate.AddDate(0, 0, 7*(rule.Interval-1))
+		}
+	}
+	// Unreachable as long as ByDay is non-empty.
+	return from.AddDate(0, 0, 7*rule.Interval)
+}
+
+func isByDay(rule *recurRule, wd time.Weekday) bool {
+	for _, d := range rule.ByDay {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// upcomingOccurrences previews the next occurrences of rule starting from
+// anchor, up to `days` days out.
+func upcomingOccurrences(rule *recurRule, anchor time.Time, now time.Time, days int) []time.Time {
+	horizon := now.AddDate(0, 0, days)
+	var occurrences []time.Time
+	cur := anchor
+	for {
+		cur = advanceOnce(rule, cur)
+		if cur.After(horizon) {
+			break
+		}
+		if cur.After(now) {
+			occurrences = append(occurrences, cur)
+		}
+	}
+	return occurrences
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAdvanceOnceMonthlyByMonthDayClampsShortMonths(t *testing.T) {
+	rule := &recurRule{Freq: "MONTHLY", Interval: 1, ByMonthDay: 31}
+
+	// Walking a BYMONTHDAY=31 rule forward a full year must hit every
+	// month exactly once, clamped to that month's last day instead of
+	// overflowing into (and skipping) the next one.
+	want := []time.Time{
+		date(2026, time.February, 28),
+		date(2026, time.March, 31),
+		date(2026, time.April, 30),
+		date(2026, time.May, 31),
+		date(2026, time.June, 30),
+		date(2026, time.July, 31),
+		date(2026, time.August, 31),
+		date(2026, time.September, 30),
+		date(2026, time.October, 31),
+		date(2026, time.November, 30),
+		date(2026, time.December, 31),
+		date(2027, time.January, 31),
+	}
+
+	got := date(2026, time.January, 31)
+	for i, w := range want {
+		got = advanceOnce(rule, got)
+		if !got.Equal(w) {
+			t.Fatalf("step %d: got %s, want %s", i, got.Format("2006-01-02"), w.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestAdvanceOnceMonthlyWithoutByMonthDayClampsShortMonths(t *testing.T) {
+	// No BYMONTHDAY set: the target day comes from the anchor's own
+	// day-of-month (31, here), so a bare "FREQ=MONTHLY" anchored on a long
+	// month must clamp the same way an explicit BYMONTHDAY=31 does, rather
+	// than overflowing January 31st straight into March.
+	rule := &recurRule{Freq: "MONTHLY", Interval: 1}
+
+	got := advanceOnce(rule, date(2026, time.January, 31))
+	want := date(2026, time.February, 28)
+	if !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+}
+
+func TestNextOccurrenceSkipsMissedCycles(t *testing.T) {
+	rule := &recurRule{Freq: "DAILY", Interval: 3}
+	anchor := date(2026, time.January, 1)
+	now := date(2026, time.January, 10)
+
+	next := nextOccurrence(rule, anchor, now)
+	if !next.After(now) {
+		t.Fatalf("nextOccurrence(%s) = %s, want strictly after now", anchor.Format("2006-01-02"), next.Format("2006-01-02"))
+	}
+	if !next.Equal(date(2026, time.January, 13)) {
+		t.Fatalf("got %s, want 2026-01-13", next.Format("2006-01-02"))
+	}
+}
+
+func TestParseRecurShorthandAndRRule(t *testing.T) {
+	cases := []struct {
+		in   string
+		freq string
+		intv int
+	}{
+		{"daily", "DAILY", 1},
+		{"every 2 weeks", "WEEKLY", 2},
+		{"FREQ=MONTHLY;INTERVAL=3", "MONTHLY", 3},
+	}
+	for _, c := range cases {
+		rule, err := parseRecur(c.in)
+		if err != nil {
+			t.Fatalf("parseRecur(%q) error: %v", c.in, err)
+		}
+		if rule.Freq != c.freq || rule.Interval != c.intv {
+			t.Fatalf("parseRecur(%q) = %+v, want Freq=%s Interval=%d", c.in, rule, c.freq, c.intv)
+		}
+	}
+}
+
+func TestParseRecurRejectsGarbage(t *testing.T) {
+	if _, err := parseRecur("whenever I feel like it"); err == nil {
+		t.Fatal("expected an error for an unrecognized recurrence rule")
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// outputFormat selects how cmdList/cmdShow render projects for scripting.
+// The zero value ("" / "table") keeps the original human-readable table.
+type outputFormat string
+
+const (
+	formatTable    outputFormat = "table"
+	formatJSON     outputFormat = "json"
+	formatTSV      outputFormat = "tsv"
+	formatTemplate outputFormat = "template"
+)
+
+func parseOutputFormat(s string) (outputFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "table":
+		return formatTable, nil
+	case "json":
+		return formatJSON, nil
+	case "tsv":
+		return formatTSV, nil
+	case "template":
+		return formatTemplate, nil
+	default:
+		return "", fmt.Errorf("unknown -format %q (expected table, json, tsv, or template)", s)
+	}
+}
+
+// renderedProject is the JSON shape for `-format json`: the stored fields
+// plus the computed ones scripts actually want (days_until_due, overdue,
+// status_label) so callers don't have to re-derive them from due_date.
+type renderedProject struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	StartDate    time.Time `json:"start_date"`
+	DueDate      time.Time `json:"due_date"`
+	Done         bool      `json:"done"`
+	Tags         []string  `json:"tags,omitempty"`
+	Notes        string    `json:"notes,omitempty"`
+	Recur        string    `json:"recur,omitempty"`
+	DaysUntilDue int       `json:"days_until_due"`
+	Overdue      bool      `json:"overdue"`
+	StatusLabel  string    `json:"status_label"`
+}
+
+func computeRendered(p Project, now time.Time) renderedProject {
+	_, statusLabel := statusColorAndLabel(p, now)
+	return renderedProject{
+		ID:           p.ID,
+		Name:         p.Name,
+		StartDate:    p.StartDate,
+		DueDate:      p.DueDate,
+		Done:         p.Done,
+		Tags:         p.Tags,
+		Notes:        p.Notes,
+		Recur:        p.Recur,
+		DaysUntilDue: daysLeft(p, now),
+		Overdue:      isOverdue(p, now),
+		StatusLabel:  statusLabel,
+	}
+}
+
+func renderJSON(w io.Writer, projects []Project, now time.Time) error {
+	out := make([]renderedProject, len(projects))
+	for i, p := range projects {
+		out[i] = computeRendered(p, now)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func renderTSV(w io.Writer, projects []Project, now time.Time) error {
+	fmt.Fprintln(w, "id\tname\tstart\tdue\tstatus\ttags\tdays_until_due")
+	for _, p := range projects {
+		_, statusLabel := statusColorAndLabel(p, now)
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			p.ID, p.Name, formatDate(p.StartDate), formatDate(p.DueDate),
+			statusLabel, strings.Join(p.Tags, ","), daysLeft(p, now))
+	}
+	return nil
+}
+
+var templateFuncs = template.FuncMap{
+	"overdue": func(p Project) bool { return isOverdue(p, time.Now()) },
+	"daysLeft": func(p Project) int { return daysLeft(p, time.Now()) },
+	"color": func(p Project) string {
+		c, _ := statusColorAndLabel(p, time.Now())
+		return c
+	},
+}
+
+func renderTemplate(w io.Writer, tmplStr string, projects []Project) error {
+	tmpl, err := template.New("projtrack").Funcs(templateFuncs).Parse(tmplStr)
+	if err != nil {
+		return fmt.Errorf("parsing -template: %w", err)
+	}
+	for _, p := range projects {
+		if err := tmpl.Execute(w, p); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func daysLeft(p Project, now time.Time) int {
+	return int(p.DueDate.Sub(now).Hours() / 24)
+}
+
+// colorEnabled decides whether to emit ANSI escapes: never if -no-color was
+// passed, and never when stdout isn't a terminal (piping into a file or
+// `grep` shouldn't have to deal with escape codes).
+func colorEnabled(noColor bool) bool {
+	if noColor {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
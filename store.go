@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Store abstracts the project persistence backend so the command
+// implementations don't care whether projects live in a local JSON file, a
+// SQLite database, or a shared HTTP server.
+type Store interface {
+	List() ([]Project, error)
+	Get(id int) (*Project, error)
+	Put(p Project) error
+	Delete(id int) error
+
+	// ListAll is like List but also returns projects tombstoned by Delete.
+	// mergeStores needs this: a Deleted row must still be compared by
+	// UpdatedAt against the other side, not treated as if it never existed.
+	ListAll() ([]Project, error)
+
+	// Watch returns a channel that receives a value whenever the backing
+	// store changes underneath this process (e.g. another host pushed an
+	// update via sync), and a stop func to release any resources. Backends
+	// that can't observe external changes return a nil channel.
+	Watch() (<-chan struct{}, func())
+}
+
+// resolveStore picks a backend based on PROJTRACK_STORE ("file", "sqlite",
+// or an "http(s)://..." URL), falling back to the `store = "..."` key in
+// ~/.projtrack.toml, and finally to the plain JSON file.
+func resolveStore() (Store, error) {
+	spec := os.Getenv("PROJTRACK_STORE")
+	if spec == "" {
+		spec = configuredStoreSpec()
+	}
+
+	switch {
+	case spec == "" || spec == "file":
+		path, err := storagePath()
+		if err != nil {
+			return nil, err
+		}
+		return &fileStore{path: path}, nil
+	case spec == "sqlite":
+		path, err := sqliteStoragePath()
+		if err != nil {
+			return nil, err
+		}
+		return newSQLiteStore(path)
+	case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+		return newHTTPStore(spec), nil
+	default:
+		return nil, fmt.Errorf("unrecognized PROJTRACK_STORE %q (expected file, sqlite, or http(s)://...)", spec)
+	}
+}
+
+// configuredStoreSpec reads the `store = "..."` key from ~/.projtrack.toml,
+// if present. Only a minimal flat `key = "value"` subset of TOML is parsed;
+// this tool has no other use for a full TOML dependency.
+func configuredStoreSpec() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".projtrack.toml"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, val, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(key) != "store" {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(val), `"`)
+	}
+	return ""
+}
+
+// fileStore is the original backend: the whole project list as one JSON
+// array on disk. It is not safe for concurrent use from multiple hosts,
+// which is exactly what the sqlite and http backends exist to fix.
+type fileStore struct {
+	path string
+}
+
+func (s *fileStore) readAll() ([]Project, error) {
+	if _, err := os.Stat(s.path); os.IsNotExist(err) {
+		return []Project{}, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	var projects []Project
+	if err := json.Unmarshal(data, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (s *fileStore) writeAll(projects []Project) error {
+	data, err := json.MarshalIndent(projects, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o644)
+}
+
+func (s *fileStore) List() ([]Project, error) {
+	projects, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+	out := projects[:0]
+	for _, p := range projects {
+		if !p.Deleted {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (s *fileStore) ListAll() ([]Project, error) {
+	return s.readAll()
+}
+
+func (s *fileStore) Get(id int) (*Project, error) {
+	projects, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+	for i := range projects {
+		if projects[i].ID == id && !projects[i].Deleted {
+			return &projects[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fileStore) Put(p Project) error {
+	projects, err := s.readAll()
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		if projects[i].ID == p.ID {
+			projects[i] = p
+			return s.writeAll(projects)
+		}
+	}
+	return s.writeAll(append(projects, p))
+}
+
+// Delete tombstones the project instead of removing it outright, so the
+// deletion itself has an UpdatedAt that can propagate to other hosts via
+// mergeStores. A hard removal here would make the file backend forget a
+// project was ever deleted as soon as it's overwritten, letting a stale
+// copy on another host resurrect it on the next sync.
+func (s *fileStore) Delete(id int) error {
+	projects, err := s.readAll()
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		if projects[i].ID == id {
+			projects[i].Deleted = true
+			projects[i].UpdatedAt = time.Now()
+			return s.writeAll(projects)
+		}
+	}
+	return nil
+}
+
+func (s *fileStore) Watch() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
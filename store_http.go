@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// httpStore talks to a small REST API so multiple hosts can share one
+// tracker. This is the client side only; nothing in this tree implements
+// the server, so PROJTRACK_STORE=http(s)://... requires running your own
+// against this contract:
+//
+//	GET    /projects                   -> 200, JSON array of live (non-deleted) Project
+//	GET    /projects?include_deleted=1 -> 200, JSON array of every Project, tombstones included
+//	GET    /projects/{id}              -> 200 + JSON Project, or 404 if missing or deleted
+//	PUT    /projects/{id}               body: JSON Project -> 200 or 201 on upsert
+//	DELETE /projects/{id}               -> 200 or 204; should tombstone (Deleted: true), not remove the row,
+//	                                        so a later ListAll/sync can still see the delete happened
+//
+// See sqliteStore (store_sqlite.go) for a Store implementation with the same
+// tombstone semantics; a reference server would mostly be that logic behind
+// an HTTP handler.
+type httpStore struct {
+	baseURL string
+	client  *http.Client
+}
+
+func newHTTPStore(baseURL string) *httpStore {
+	return &httpStore{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *httpStore) List() ([]Project, error) {
+	return s.listFrom(s.baseURL + "/projects")
+}
+
+// ListAll is like List but also returns tombstoned rows, so mergeStores can
+// compare a remote delete's UpdatedAt instead of mistaking it for "never
+// existed".
+func (s *httpStore) ListAll() ([]Project, error) {
+	return s.listFrom(s.baseURL + "/projects?include_deleted=1")
+}
+
+func (s *httpStore) listFrom(url string) ([]Project, error) {
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	var projects []Project
+	if err := json.NewDecoder(resp.Body).Decode(&projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (s *httpStore) Get(id int) (*Project, error) {
+	resp, err := s.client.Get(fmt.Sprintf("%s/projects/%d", s.baseURL, id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET /projects/%d: unexpected status %s", id, resp.Status)
+	}
+	var p Project
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+	// Match fileStore/sqliteStore: Get only returns live projects, same as
+	// List. Without this, deleting the same project twice against an
+	// http(s) backend would report success both times instead of the
+	// "No project with ID" the other backends give on the second call.
+	if p.Deleted {
+		return nil, nil
+	}
+	return &p, nil
+}
+
+func (s *httpStore) Put(p Project) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/projects/%d", s.baseURL, p.ID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("PUT /projects/%d: unexpected status %s", p.ID, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpStore) Delete(id int) error {
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/projects/%d", s.baseURL, id), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("DELETE /projects/%d: unexpected status %s", id, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpStore) Watch() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
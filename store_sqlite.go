@@ -0,0 +1,149 @@
+//go:build sqlite
+
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteStore stores each project as a JSON blob alongside a handful of
+// indexed columns (due_date, done) that the list/report commands filter
+// and sort on most often.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS projects (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	data TEXT NOT NULL,
+	due_date TEXT NOT NULL,
+	done INTEGER NOT NULL,
+	updated_at TEXT NOT NULL,
+	deleted INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_projects_due_date ON projects(due_date);
+CREATE INDEX IF NOT EXISTS idx_projects_done ON projects(done);
+`
+
+func sqliteStoragePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".projtrack.db"), nil
+}
+
+func newSQLiteStore(path string) (*sqliteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return nil, fmt.Errorf("initializing sqlite schema: %w", err)
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) List() ([]Project, error) {
+	return s.query(`SELECT data FROM projects WHERE deleted = 0`)
+}
+
+// ListAll is like List but also returns tombstoned rows, so mergeStores can
+// compare a remote delete's UpdatedAt instead of mistaking it for "never
+// existed".
+func (s *sqliteStore) ListAll() ([]Project, error) {
+	return s.query(`SELECT data FROM projects`)
+}
+
+func (s *sqliteStore) query(q string) ([]Project, error) {
+	rows, err := s.db.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []Project
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var p Project
+		if err := json.Unmarshal([]byte(data), &p); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+	return projects, rows.Err()
+}
+
+func (s *sqliteStore) Get(id int) (*Project, error) {
+	var data string
+	err := s.db.QueryRow(`SELECT data FROM projects WHERE id = ? AND deleted = 0`, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var p Project
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Put must preserve an incoming Deleted=true (e.g. mergeStores pushing a
+// tombstone pulled from another host) rather than reviving the row by
+// hardcoding deleted=0 on every write.
+func (s *sqliteStore) Put(p Project) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`
+		INSERT INTO projects (id, name, data, due_date, done, updated_at, deleted)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name=excluded.name, data=excluded.data, due_date=excluded.due_date,
+			done=excluded.done, updated_at=excluded.updated_at, deleted=excluded.deleted
+	`, p.ID, p.Name, string(data), p.DueDate.Format(time.RFC3339), p.Done, p.UpdatedAt.Format(time.RFC3339), p.Deleted)
+	return err
+}
+
+// Delete tombstones the row via Put rather than a bare `UPDATE ... SET
+// deleted = 1`: the deleted/updated_at columns only gate List()'s WHERE
+// clause, but ListAll()/Get() decode the `data` blob, which a column-only
+// update would leave stale (Deleted still false, UpdatedAt still the
+// pre-delete value) and invisible to mergeStores.
+func (s *sqliteStore) Delete(id int) error {
+	var data string
+	err := s.db.QueryRow(`SELECT data FROM projects WHERE id = ?`, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var p Project
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return err
+	}
+	p.Deleted = true
+	p.UpdatedAt = time.Now()
+	return s.Put(p)
+}
+
+func (s *sqliteStore) Watch() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
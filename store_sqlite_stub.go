@@ -0,0 +1,42 @@
+//go:build !sqlite
+
+package main
+
+import "fmt"
+
+// sqliteStore is stubbed out in default builds so `go build ./...` doesn't
+// require a cgo sqlite driver. Build with `-tags sqlite` to get the real
+// implementation in store_sqlite.go.
+type sqliteStore struct{}
+
+func sqliteStoragePath() (string, error) {
+	return "", fmt.Errorf("sqlite support not compiled in (rebuild with -tags sqlite)")
+}
+
+func newSQLiteStore(path string) (*sqliteStore, error) {
+	return nil, fmt.Errorf("sqlite support not compiled in (rebuild with -tags sqlite)")
+}
+
+func (s *sqliteStore) List() ([]Project, error) {
+	return nil, fmt.Errorf("sqlite support not compiled in")
+}
+
+func (s *sqliteStore) ListAll() ([]Project, error) {
+	return nil, fmt.Errorf("sqlite support not compiled in")
+}
+
+func (s *sqliteStore) Get(id int) (*Project, error) {
+	return nil, fmt.Errorf("sqlite support not compiled in")
+}
+
+func (s *sqliteStore) Put(p Project) error {
+	return fmt.Errorf("sqlite support not compiled in")
+}
+
+func (s *sqliteStore) Delete(id int) error {
+	return fmt.Errorf("sqlite support not compiled in")
+}
+
+func (s *sqliteStore) Watch() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// cmdSync reconciles the local JSON file against the backend configured via
+// PROJTRACK_STORE or ~/.projtrack.toml, using last-write-wins on UpdatedAt
+// and honoring Deleted tombstones. It only makes sense when a shared
+// backend (sqlite or http) is actually configured.
+func cmdSync(args []string) {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+	fs.Parse(args)
+
+	spec := os.Getenv("PROJTRACK_STORE")
+	if spec == "" {
+		spec = configuredStoreSpec()
+	}
+	if spec == "" || spec == "file" {
+		fmt.Println("PROJTRACK_STORE is not set to a shared backend (sqlite or http(s)://...); nothing to sync against.")
+		os.Exit(1)
+	}
+
+	localPath, err := storagePath()
+	if err != nil {
+		fmt.Printf("Error resolving local storage path: %v\n", err)
+		os.Exit(1)
+	}
+	local := &fileStore{path: localPath}
+
+	remote, err := resolveStore()
+	if err != nil {
+		fmt.Printf("Error resolving remote store: %v\n", err)
+		os.Exit(1)
+	}
+
+	pulled, pushed, err := mergeStores(local, remote)
+	if err != nil {
+		fmt.Printf("Error syncing: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Synced with %s: %d pulled, %d pushed.\n", spec, pulled, pushed)
+}
+
+// mergeStores applies last-write-wins merge between local and remote: for
+// each project ID present on either side, the copy with the newer UpdatedAt
+// wins and is written (via Put) to the other side. A Deleted tombstone is
+// just a field on that copy, so it propagates the same way as any other
+// edit - no separate delete step, which also keeps repeated syncs of an
+// already-converged tombstone a no-op instead of re-stamping UpdatedAt
+// forever.
+func mergeStores(local, remote Store) (pulled, pushed int, err error) {
+	// ListAll, not List: a tombstoned project must still be compared by
+	// UpdatedAt below. List() hides Deleted rows, which would make a
+	// project deleted on one side look like it never existed there and
+	// get resurrected by the hasLocal/hasRemote-only branches.
+	localProjects, err := local.ListAll()
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing local projects: %w", err)
+	}
+	remoteProjects, err := remote.ListAll()
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing remote projects: %w", err)
+	}
+
+	localByID := make(map[int]Project, len(localProjects))
+	for _, p := range localProjects {
+		localByID[p.ID] = p
+	}
+	remoteByID := make(map[int]Project, len(remoteProjects))
+	for _, p := range remoteProjects {
+		remoteByID[p.ID] = p
+	}
+
+	ids := make(map[int]struct{}, len(localByID)+len(remoteByID))
+	for id := range localByID {
+		ids[id] = struct{}{}
+	}
+	for id := range remoteByID {
+		ids[id] = struct{}{}
+	}
+
+	for id := range ids {
+		lp, hasLocal := localByID[id]
+		rp, hasRemote := remoteByID[id]
+
+		switch {
+		case hasLocal && !hasRemote:
+			if err := remote.Put(lp); err != nil {
+				return pulled, pushed, fmt.Errorf("pushing project #%d: %w", id, err)
+			}
+			pushed++
+		case hasRemote && !hasLocal:
+			if err := local.Put(rp); err != nil {
+				return pulled, pushed, fmt.Errorf("pulling project #%d: %w", id, err)
+			}
+			pulled++
+		case rp.UpdatedAt.After(lp.UpdatedAt):
+			if err := local.Put(rp); err != nil {
+				return pulled, pushed, fmt.Errorf("pulling project #%d: %w", id, err)
+			}
+			pulled++
+		case lp.UpdatedAt.After(rp.UpdatedAt):
+			if err := remote.Put(lp); err != nil {
+				return pulled, pushed, fmt.Errorf("pushing project #%d: %w", id, err)
+			}
+			pushed++
+		}
+	}
+
+	return pulled, pushed, nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// memStore is a minimal in-memory Store used only to exercise mergeStores.
+// It mirrors fileStore's tombstone semantics: Delete marks Deleted instead
+// of removing the row, and List hides Deleted rows while ListAll doesn't.
+type memStore struct {
+	projects map[int]Project
+}
+
+func newMemStore(projects ...Project) *memStore {
+	m := &memStore{projects: map[int]Project{}}
+	for _, p := range projects {
+		m.projects[p.ID] = p
+	}
+	return m
+}
+
+func (m *memStore) List() ([]Project, error) {
+	var out []Project
+	for _, p := range m.projects {
+		if !p.Deleted {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) ListAll() ([]Project, error) {
+	var out []Project
+	for _, p := range m.projects {
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (m *memStore) Get(id int) (*Project, error) {
+	if p, ok := m.projects[id]; ok && !p.Deleted {
+		return &p, nil
+	}
+	return nil, nil
+}
+
+func (m *memStore) Put(p Project) error {
+	m.projects[p.ID] = p
+	return nil
+}
+
+func (m *memStore) Delete(id int) error {
+	p, ok := m.projects[id]
+	if !ok {
+		return nil
+	}
+	p.Deleted = true
+	p.UpdatedAt = time.Now()
+	m.projects[id] = p
+	return nil
+}
+
+func (m *memStore) Watch() (<-chan struct{}, func()) {
+	return nil, func() {}
+}
+
+// TestMergeStoresPropagatesDeleteWithoutResurrecting reproduces the
+// reported bug: host A deletes project #7 on the shared (remote) store,
+// then host B - whose local cache still holds the pre-delete copy - syncs.
+// The delete must win and stay deleted everywhere, not come back.
+func TestMergeStoresPropagatesDeleteWithoutResurrecting(t *testing.T) {
+	older := time.Now().Add(-time.Hour)
+	newer := time.Now()
+
+	local := newMemStore(Project{ID: 7, Name: "Stale copy", UpdatedAt: older})
+	remote := newMemStore(Project{ID: 7, Name: "Stale copy", UpdatedAt: older, Deleted: true})
+	// Simulate the tombstone being newer than host B's stale copy, which is
+	// what an actual delete-then-sync sequence produces.
+	remote.projects[7] = Project{ID: 7, Name: "Stale copy", UpdatedAt: newer, Deleted: true}
+
+	if _, _, err := mergeStores(local, remote); err != nil {
+		t.Fatalf("mergeStores error: %v", err)
+	}
+
+	lp, _ := local.Get(7)
+	rp, _ := remote.Get(7)
+	if lp != nil {
+		t.Fatalf("project #7 resurrected locally: %+v", lp)
+	}
+	if rp != nil {
+		t.Fatalf("project #7 resurrected remotely: %+v", rp)
+	}
+}
+
+// TestMergeStoresIsIdempotentOnceTombstonesConverge guards against
+// mergeStores re-stamping an already-converged tombstone's UpdatedAt on
+// every call, which would make two already-deleted, already-synced sides
+// drift apart and look like new work on every subsequent sync.
+func TestMergeStoresIsIdempotentOnceTombstonesConverge(t *testing.T) {
+	local := newMemStore(Project{ID: 7, Name: "Stale copy", UpdatedAt: time.Now()})
+	remote := newMemStore(Project{ID: 7, Name: "Stale copy", UpdatedAt: time.Now(), Deleted: true})
+
+	if _, _, err := mergeStores(local, remote); err != nil {
+		t.Fatalf("first mergeStores error: %v", err)
+	}
+	// First sync pulls the remote tombstone, then converges both sides onto
+	// it. A second sync against that already-converged state is what must
+	// be a no-op.
+	pulled, pushed, err := mergeStores(local, remote)
+	if err != nil {
+		t.Fatalf("second mergeStores error: %v", err)
+	}
+	if pulled != 0 || pushed != 0 {
+		t.Fatalf("second sync after convergence should be a no-op, got pulled=%d pushed=%d", pulled, pushed)
+	}
+}
+
+// TestMergeStoresPushesNewLocalProject covers the ordinary, non-tombstone
+// path still working once List() was replaced with ListAll() above.
+func TestMergeStoresPushesNewLocalProject(t *testing.T) {
+	local := newMemStore(Project{ID: 3, Name: "New on host", UpdatedAt: time.Now()})
+	remote := newMemStore()
+
+	pulled, pushed, err := mergeStores(local, remote)
+	if err != nil {
+		t.Fatalf("mergeStores error: %v", err)
+	}
+	if pushed != 1 || pulled != 0 {
+		t.Fatalf("got pulled=%d pushed=%d, want pulled=0 pushed=1", pulled, pushed)
+	}
+	if _, ok := remote.projects[3]; !ok {
+		t.Fatal("expected project #3 to be pushed to remote")
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Column describes one renderable field in a project table. printTable uses
+// these to build both the header row and each project's cells.
+type Column struct {
+	Key    string
+	Header string
+	Value  func(p Project, now time.Time, color bool) string
+}
+
+var allColumns = []Column{
+	{"id", "ID", func(p Project, now time.Time, color bool) string {
+		return fmt.Sprintf("%d", p.ID)
+	}},
+	{"name", "NAME", func(p Project, now time.Time, color bool) string {
+		return truncate(p.Name, 30)
+	}},
+	{"start", "START", func(p Project, now time.Time, color bool) string {
+		return formatDate(p.StartDate)
+	}},
+	{"due", "DUE", func(p Project, now time.Time, color bool) string {
+		return formatDate(p.DueDate)
+	}},
+	{"status", "STATUS", func(p Project, now time.Time, color bool) string {
+		c, label := statusColorAndLabel(p, now)
+		if !color {
+			return label
+		}
+		return c + label + ColorReset
+	}},
+	{"tags", "TAGS", func(p Project, now time.Time, color bool) string {
+		return truncate(strings.Join(p.Tags, ","), 20)
+	}},
+	{"notes", "NOTES", func(p Project, now time.Time, color bool) string {
+		return truncate(p.Notes, 30)
+	}},
+	{"age", "AGE", func(p Project, now time.Time, color bool) string {
+		days := int(now.Sub(p.StartDate).Hours() / 24)
+		if days < 0 {
+			days = 0
+		}
+		return fmt.Sprintf("%dd", days)
+	}},
+}
+
+func columnByKey(key string) (Column, bool) {
+	for _, c := range allColumns {
+		if c.Key == key {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+var defaultColumnKeys = []string{"id", "name", "start", "due", "status", "tags"}
+
+// parseColumns resolves a comma-separated -cols spec (e.g.
+// "id,name,due,status") into Columns, in the order given. An empty spec
+// falls back to the original list layout.
+func parseColumns(spec string) ([]Column, error) {
+	keys := defaultColumnKeys
+	if strings.TrimSpace(spec) != "" {
+		keys = strings.Split(spec, ",")
+	}
+
+	cols := make([]Column, 0, len(keys))
+	for _, key := range keys {
+		key = strings.TrimSpace(strings.ToLower(key))
+		col, ok := columnByKey(key)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q (available: id,name,start,due,status,tags,notes,age)", key)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// visibleWidth returns the printable width of s, ignoring ANSI escape
+// sequences so color codes don't eat into the padding budget.
+func visibleWidth(s string) int {
+	return len(ansiEscape.ReplaceAllString(s, ""))
+}
+
+// padRight pads s out to width printable columns, leaving any ANSI escapes
+// inside it intact.
+func padRight(s string, width int) string {
+	pad := width - visibleWidth(s)
+	if pad <= 0 {
+		return s
+	}
+	return s + strings.Repeat(" ", pad)
+}
+
+const minColumnWidth = 4
+
+// printTable renders projects as an aligned table using cols, sizing each
+// column from its header and the widest (ANSI-stripped) cell in it.
+func printTable(w io.Writer, projects []Project, cols []Column, now time.Time, color bool) {
+	widths := make([]int, len(cols))
+	for i, col := range cols {
+		widths[i] = len(col.Header)
+	}
+
+	rows := make([][]string, len(projects))
+	for i, p := range projects {
+		row := make([]string, len(cols))
+		for j, col := range cols {
+			cell := col.Value(p, now, color)
+			row[j] = cell
+			if vw := visibleWidth(cell); vw > widths[j] {
+				widths[j] = vw
+			}
+		}
+		rows[i] = row
+	}
+	for i, width := range widths {
+		if width < minColumnWidth {
+			widths[i] = minColumnWidth
+		}
+	}
+
+	var header strings.Builder
+	for i, col := range cols {
+		header.WriteString(padRight(col.Header, widths[i]))
+		if i < len(cols)-1 {
+			header.WriteString(" ")
+		}
+	}
+	fmt.Fprintln(w, header.String())
+
+	totalWidth := len(cols) - 1 // spaces between columns
+	for _, width := range widths {
+		totalWidth += width
+	}
+	fmt.Fprintln(w, strings.Repeat("-", totalWidth))
+
+	for _, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			line.WriteString(padRight(cell, widths[i]))
+			if i < len(row)-1 {
+				line.WriteString(" ")
+			}
+		}
+		fmt.Fprintln(w, line.String())
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// openStreak returns the currently open streak on p, if any.
+func openStreak(p Project) (Streak, bool) {
+	for _, s := range p.Streaks {
+		if s.open() {
+			return s, true
+		}
+	}
+	return Streak{}, false
+}
+
+// totalLogged sums the duration of every streak on p, treating an open
+// streak as running until now.
+func totalLogged(p Project, now time.Time) time.Duration {
+	var total time.Duration
+	for _, s := range p.Streaks {
+		total += s.duration(now)
+	}
+	return total
+}
+
+// formatDuration renders a duration the way the CLI displays elapsed/logged
+// time: minute-resolution, omitting the seconds component.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	if h == 0 {
+		return fmt.Sprintf("%dm", m)
+	}
+	return fmt.Sprintf("%dh%02dm", h, m)
+}
+
+func cmdStart(args []string) {
+	fs := flag.NewFlagSet("start", flag.ExitOnError)
+	idStr := fs.String("id", "", "Project ID to start tracking time against (required)")
+	desc := fs.String("desc", "", "Short description of what you're working on (optional)")
+	fs.Parse(args)
+
+	if *idStr == "" {
+		fmt.Println("Error: -id is required")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	id, err := strconv.Atoi(*idStr)
+	if err != nil {
+		fmt.Printf("Invalid ID: %v\n", err)
+		os.Exit(1)
+	}
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	if state.CurrentProjectID != 0 {
+		fmt.Printf("A streak is already running on project #%d. Run `projtrack stop` first.\n", state.CurrentProjectID)
+		os.Exit(1)
+	}
+
+	p := state.find(id)
+	if p == nil {
+		fmt.Printf("No project with ID %d\n", id)
+		os.Exit(1)
+	}
+
+	now := time.Now()
+	p.Streaks = append(p.Streaks, Streak{Start: now, Description: *desc})
+	p.UpdatedAt = now
+	state.CurrentProjectID = id
+
+	if err := savePartial(state, id); err != nil {
+		fmt.Printf("Error saving projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Started tracking time on project #%d: %s\n", p.ID, p.Name)
+}
+
+func cmdStop(args []string) {
+	fs := flag.NewFlagSet("stop", flag.ExitOnError)
+	fs.Parse(args)
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	if state.CurrentProjectID == 0 {
+		fmt.Println("No streak is currently running.")
+		os.Exit(1)
+	}
+
+	p := state.find(state.CurrentProjectID)
+	if p == nil {
+		fmt.Printf("Currently running project #%d no longer exists.\n", state.CurrentProjectID)
+		state.CurrentProjectID = 0
+		if err := savePartial(state); err != nil {
+			fmt.Printf("Error saving projects: %v\n", err)
+		}
+		os.Exit(1)
+	}
+
+	now := time.Now()
+	open, ok := openStreak(*p)
+	if !ok {
+		fmt.Printf("Project #%d has no open streak.\n", p.ID)
+		state.CurrentProjectID = 0
+		if err := savePartial(state); err != nil {
+			fmt.Printf("Error saving projects: %v\n", err)
+		}
+		os.Exit(1)
+	}
+
+	for i := range p.Streaks {
+		if p.Streaks[i].open() {
+			p.Streaks[i].End = now
+			break
+		}
+	}
+	p.UpdatedAt = now
+	state.CurrentProjectID = 0
+
+	if err := savePartial(state, p.ID); err != nil {
+		fmt.Printf("Error saving projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Stopped tracking time on project #%d: %s (%s)\n", p.ID, p.Name, formatDuration(now.Sub(open.Start)))
+}
+
+func cmdReport(args []string) {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	fromStr := fs.String("from", "", "Only include streaks on or after this date (YYYY-MM-DD)")
+	toStr := fs.String("to", "", "Only include streaks on or before this date (YYYY-MM-DD)")
+	tag := fs.String("tag", "", "Filter by tag (case-insensitive)")
+	by := fs.String("by", "project", "Group by: day|week|project")
+	roundStr := fs.String("round", "0m", `Round each streak up to the nearest interval, e.g. "15m" (using time.ParseDuration)`)
+	fs.Parse(args)
+
+	round, err := time.ParseDuration(*roundStr)
+	if err != nil {
+		fmt.Printf("Invalid -round: %v\n", err)
+		os.Exit(1)
+	}
+
+	var from, to time.Time
+	if *fromStr != "" {
+		from, err = parseDate(*fromStr)
+		if err != nil {
+			fmt.Printf("Invalid -from: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if *toStr != "" {
+		to, err = parseDate(*toStr)
+		if err != nil {
+			fmt.Printf("Invalid -to: %v\n", err)
+			os.Exit(1)
+		}
+		to = to.AddDate(0, 0, 1) // -to is inclusive of the whole day
+	}
+
+	state, err := loadState()
+	if err != nil {
+		fmt.Printf("Error loading projects: %v\n", err)
+		os.Exit(1)
+	}
+
+	now := time.Now()
+	totals := map[string]time.Duration{}
+
+	for _, p := range state.Projects {
+		if !hasTag(p, *tag) {
+			continue
+		}
+		for _, s := range p.Streaks {
+			if !from.IsZero() && s.Start.Before(from) {
+				continue
+			}
+			if !to.IsZero() && s.Start.After(to) {
+				continue
+			}
+
+			d := s.duration(now)
+			if round > 0 {
+				d = d.Round(round)
+				if d == 0 && s.duration(now) > 0 {
+					d = round
+				}
+			}
+
+			key := reportKey(*by, p, s)
+			totals[key] += d
+		}
+	}
+
+	if len(totals) == 0 {
+		fmt.Println("No time logged for the given filters.")
+		return
+	}
+
+	keys := make([]string, 0, len(totals))
+	for k := range totals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tHOURS\n", strings.ToUpper(*by))
+	var grand time.Duration
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s\t%.2f\n", k, totals[k].Hours())
+		grand += totals[k]
+	}
+	fmt.Fprintf(w, "TOTAL\t%.2f\n", grand.Hours())
+	w.Flush()
+}
+
+func reportKey(by string, p Project, s Streak) string {
+	switch by {
+	case "day":
+		return s.Start.Format("2006-01-02")
+	case "week":
+		year, week := s.Start.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	default:
+		return fmt.Sprintf("#%d %s", p.ID, p.Name)
+	}
+}